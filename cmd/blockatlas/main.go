@@ -0,0 +1,32 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/platform/binance"
+)
+
+// platforms lists every chain-specific implementation the API serves.
+// Adding a new chain means appending it here, not editing the router.
+var platforms = []platform.Platform{
+	&binance.Platform{},
+}
+
+func main() {
+	router := gin.Default()
+
+	for _, p := range platforms {
+		if err := p.Init(viper.GetViper()); err != nil {
+			logrus.WithError(err).Fatalf("failed to init %s platform", p.Coin().Handle)
+		}
+		group := router.Group("/" + p.Coin().Handle)
+		platform.Setup(group, p)
+	}
+
+	if err := router.Run(); err != nil {
+		logrus.WithError(err).Fatal("server stopped")
+	}
+}
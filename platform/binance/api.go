@@ -1,7 +1,9 @@
 package binance
 
 import (
+	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -11,52 +13,184 @@ import (
 	"github.com/trustwallet/blockatlas/util"
 )
 
-var client = Client{
-	HTTPClient: http.DefaultClient,
+// Platform implements platform.Platform for the Binance DEX.
+type Platform struct {
+	client Client
+	wallet Wallet
+	tokens *TokenInfoCache
 }
 
-// Setup registers the Binance DEX route
-func Setup(router gin.IRouter) {
-	router.Use(util.RequireConfig("binance.api"))
-	router.Use(util.RequireConfig("binance.rpc"))
-	router.Use(func(c *gin.Context) {
-		client.ExplorerBaseURL = viper.GetString("binance.api")
-		client.RPCBaseURL = viper.GetString("binance.rpc")
-		c.Next()
-	})
-	router.GET("/:address", getTransactions)
+// Coin implements platform.Platform
+func (p *Platform) Coin() coin.Coin {
+	return coin.BNB
 }
 
-func getTransactions(c *gin.Context) {
-	token := c.Query("token")
-	address := c.Param("address")
+// Init implements platform.Platform
+func (p *Platform) Init(v *viper.Viper) error {
+	p.client = Client{
+		HTTPClient:      http.DefaultClient,
+		ExplorerBaseURL: v.GetString("binance.api"),
+		RPCBaseURL:      v.GetString("binance.rpc"),
+	}
+	p.wallet = &RemoteWallet{
+		HTTPClient: http.DefaultClient,
+		SignerURL:  v.GetString("binance.signer_url"),
+	}
+	p.tokens = &TokenInfoCache{client: p.client}
+	return nil
+}
 
-	transactions, err := client.GetTxsOfAddress(address, token)
-	if apiError(c, err) {
-		return
+// RequiredConfigKeys implements platform.Platform
+func (p *Platform) RequiredConfigKeys() []string {
+	return []string{"binance.api", "binance.rpc"}
+}
+
+// RegisterRoutes implements platform.Platform
+func (p *Platform) RegisterRoutes(router gin.IRouter) {
+	router.GET("/:address", p.getTransactions)
+	router.GET("/:address/stream", p.streamTxs)
+	router.GET("/tokens", p.getTokens)
+
+	// Signing support is optional: binance.signer_url gates just the /tx
+	// group (build and broadcast) instead of RequiredConfigKeys, which would
+	// block read-only listing too. Only broadcast of an unsigned transaction
+	// calls the signer backend.
+	tx := router.Group("/tx", util.RequireConfig("binance.signer_url"))
+	tx.POST("/build", p.buildTx)
+	tx.POST("/broadcast", p.broadcastTx)
+}
+
+func (p *Platform) getTokens(c *gin.Context) {
+	c.JSON(http.StatusOK, p.tokens.List())
+}
+
+// GetTxsOfAddress implements platform.Platform
+func (p *Platform) GetTxsOfAddress(address, token string) ([]models.Tx, error) {
+	transactions, err := p.client.GetTxsOfAddress(address, token)
+	if err != nil {
+		return nil, err
 	}
 
+	// Shared across the page so a multisend hash only costs one receipt fetch
+	// no matter how many of its legs touch address.
+	receipts := make(map[string]multisendParties)
+
 	var txs []models.Tx
 	for _, srcTx := range transactions.Txs {
-		tx, ok := Normalize(&srcTx, token, address)
-		if !ok || len(txs) >= models.TxPerPage {
+		legs, ok := p.Normalize(&srcTx, token, address, receipts)
+		if !ok {
 			continue
 		}
 
-		txs = append(txs, tx)
+		for _, tx := range legs {
+			if len(txs) >= models.TxPerPage {
+				break
+			}
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (p *Platform) getTransactions(c *gin.Context) {
+	token := c.Query("token")
+	address := c.Param("address")
+
+	txs, err := p.GetTxsOfAddress(address, token)
+	if apiError(c, err) {
+		return
 	}
+
 	page := models.Response(txs)
 	page.Sort()
 	c.JSON(http.StatusOK, &page)
 }
 
-// Normalize converts a Binance transaction into the generic model
-func Normalize(srcTx *Tx, token, address string) (tx models.Tx, ok bool) {
+type buildTxRequest struct {
+	From   string `json:"from" binding:"required"`
+	To     string `json:"to" binding:"required"`
+	Asset  string `json:"asset" binding:"required"`
+	Amount string `json:"amount" binding:"required"`
+	Memo   string `json:"memo"`
+}
+
+// buildTx constructs an unsigned transaction for a wallet backend to sign.
+// blockatlas never sees a private key: it only assembles the payload.
+func (p *Platform) buildTx(c *gin.Context) {
+	var req buildTxRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.String(http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	c.JSON(http.StatusOK, UnsignedTx{
+		From:   req.From,
+		To:     req.To,
+		Asset:  req.Asset,
+		Amount: req.Amount,
+		Memo:   req.Memo,
+	})
+}
+
+type broadcastTxRequest struct {
+	UnsignedTx *UnsignedTx `json:"unsigned_tx"`
+	SignedTx   []byte      `json:"signed_tx"`
+}
+
+type broadcastTxResponse struct {
+	Hash string `json:"hash"`
+}
+
+// broadcastTx submits a transaction to the Binance Chain node. If the
+// request carries an unsigned transaction instead of signed bytes, it is
+// first signed through the configured Wallet backend.
+func (p *Platform) broadcastTx(c *gin.Context) {
+	var req broadcastTxRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.String(http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	signedTx := req.SignedTx
+	if len(signedTx) == 0 {
+		if req.UnsignedTx == nil {
+			c.String(http.StatusBadRequest, "signed_tx or unsigned_tx is required")
+			return
+		}
+
+		signed, err := p.wallet.SignTx(c.Request.Context(), *req.UnsignedTx)
+		if apiError(c, err) {
+			return
+		}
+		signedTx = signed
+	}
+
+	result, err := p.client.Broadcast(signedTx)
+	if apiError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, broadcastTxResponse{Hash: result.Hash})
+}
+
+// multisendParties holds the raw inputs/outputs of a multisend transaction,
+// keyed by hash so a page with several legs of the same transaction only
+// fetches the receipt once.
+type multisendParties struct {
+	Inputs  []Input
+	Outputs []Output
+}
+
+// Normalize converts a Binance transaction into the generic model. A single
+// source transaction can expand into several models.Tx: a multisend can carry
+// more than one leg touching address, and every leg is returned instead of
+// only the first one.
+func (p *Platform) Normalize(srcTx *Tx, token, address string, receipts map[string]multisendParties) (txs []models.Tx, ok bool) {
 	hash := srcTx.Hash
 	value := util.DecimalExp(string(srcTx.Value), 8)
 	fee := util.DecimalExp(string(srcTx.Fee), 8)
 	asset := srcTx.Asset
-	tx = models.Tx{
+	base := models.Tx{
 		ID:    hash,
 		Coin:  coin.BNB,
 		Date:  srcTx.Timestamp / 1000,
@@ -67,19 +201,23 @@ func Normalize(srcTx *Tx, token, address string) (tx models.Tx, ok bool) {
 
 	// Condition for native transfer (BNB)
 	if asset == "BNB" && srcTx.Type == TRANSFER && token == "" {
+		tx := base
 		tx.From = srcTx.FromAddr
 		tx.To = srcTx.ToAddr
 		tx.Meta = models.Transfer{
 			Value: models.Amount(value),
 		}
-		return tx, true
+		return []models.Tx{tx}, true
 	}
 
 	// Condiiton for native token transfer
 	if (asset != "" && asset == token) && srcTx.Type == TRANSFER {
+		info, _ := p.tokens.Get(srcTx.Asset)
+		tx := base
 		tx.From = srcTx.FromAddr
 		tx.To = srcTx.ToAddr
 		tx.Meta = models.NativeTokenTransfer{
+			Name:     info.Name,
 			TokenID:  srcTx.Asset,
 			Symbol:   srcTx.MappedAsset,
 			Value:    models.Amount(value),
@@ -88,85 +226,192 @@ func Normalize(srcTx *Tx, token, address string) (tx models.Tx, ok bool) {
 			To:       srcTx.ToAddr,
 		}
 
-		return tx, true
+		return []models.Tx{tx}, true
 	}
 
 	// Conditin where explorer does not return sender/recepient for multisend transaction
 	if (srcTx.FromAddr == "" || srcTx.ToAddr == "") && srcTx.Type == TRANSFER {
-		receipt, _ := client.getTransactionReceipt(hash)
-		zeroMsgValue := receipt.TxReceipts.Value.Msg[0].MsgValue
-		zeroInput := zeroMsgValue.Inputs[0]
-		outputs := zeroMsgValue.Outputs
-		zeroOutputAdress := outputs[0].Address
-
-		// Condition for native transfer
-		if zeroInput.Coins[0].Denom == "BNB" {
-			if zeroInput.Address == address {
-				tx.From = address
-				tx.To = zeroOutputAdress  // Pick 0 index as main receipient
-				tx.Meta = models.Transfer{
-					Value: models.Amount(zeroInput.Coins[0].Amount),
-				}
-				return tx, true
+		parties, err := p.getMultisendParties(hash, receipts)
+		if err != nil {
+			return nil, false
+		}
+
+		var legs []models.Tx
+
+		// address sent one or more coins: emit a leg per coin of every other
+		// output, valued at address's share of that coin. A multisend doesn't pair
+		// one input 1:1 with one output, so when several addresses fund the
+		// same output we prorate by each sender's share of the total input
+		// for that denom rather than crediting the whole output to everyone
+		// who contributed. Outputs back to address itself are change, not an
+		// outgoing leg, and are left to the receiver loop below.
+		inputTotals := inputTotalsByDenom(parties.Inputs)
+		for _, out := range parties.Outputs {
+			if out.Address == address {
+				continue
 			}
+			for _, c := range out.Coins {
+				share := senderShare(parties.Inputs, address, c.Denom, inputTotals)
+				if share.Sign() == 0 {
+					continue
+				}
 
-			coin := getCoinOutput(outputs, address)
-			tx.To = address
-			tx.From = zeroOutputAdress
-			tx.Meta = models.Transfer{
-				Value: models.Amount(coin.Amount),
+				sent := new(big.Rat).Mul(share, mustParseRat(string(c.Amount)))
+				tx := base
+				tx.From = address
+				tx.To = out.Address
+				tx.Meta = p.multisendMetaFor(c.Denom, formatRat(sent), address, out.Address)
+				legs = append(legs, tx)
 			}
-			return tx, true
 		}
 
-		// Condition for token_transfer
-		if zeroInput.Coins[0].Denom != "BNB" {
-			if zeroInput.Address == address {
-				tx.From = address
-				tx.To = zeroOutputAdress  // Pick 0 index as main receipient
-				tx.Meta = models.TokenTransfer{
-					Name: "", // TODO: Replace with actual name
-					Symbol: zeroInput.Coins[0].Denom,
-					TokenID: "", // TODO: Replace with actual tokenID
-					Decimals: 8,
-					From: address,
-					To: zeroOutputAdress,
-					Value: models.Amount(zeroInput.Coins[0].Amount),
-				}
-				return tx, true
+		// address received one or more coins: emit a leg per coin, attributed
+		// to the first input as the sender (multisend doesn't pair inputs to
+		// outputs 1:1, so this mirrors the explorer's own "main sender" choice).
+		from := parties.Outputs[0].Address
+		if len(parties.Inputs) > 0 {
+			from = parties.Inputs[0].Address
+		}
+		for _, out := range parties.Outputs {
+			if out.Address != address {
+				continue
 			}
+			for _, c := range out.Coins {
+				tx := base
+				tx.From = from
+				tx.To = address
+				tx.Meta = p.multisendMeta(c, from, address)
+				legs = append(legs, tx)
+			}
+		}
+
+		if len(legs) == 0 {
+			return nil, false
+		}
+		return legs, true
+	}
 
-			coin := getCoinOutput(outputs, address)
-			tx.From = zeroOutputAdress
-			tx.To = address
-			tx.Meta = models.TokenTransfer{
-				Name: "", // TODO: Replace with actual name
-				Symbol: coin.Denom,
-				TokenID: "", // TODO: Replace with actual tokenID
-				Decimals: 8,
-				From: zeroOutputAdress,
-				To: address,
-				Value: models.Amount(coin.Amount),
+	return nil, false
+}
 
-			}
+// multisendMeta builds the Transfer/TokenTransfer meta for a single multisend leg.
+func (p *Platform) multisendMeta(c Coin, from, to string) interface{} {
+	return p.multisendMetaFor(c.Denom, string(c.Amount), from, to)
+}
 
-			return tx, true
+// multisendMetaFor is multisendMeta taken apart into its denom/amount so a
+// prorated sender amount can be passed through without boxing it back into a Coin.
+func (p *Platform) multisendMetaFor(denom, amount, from, to string) interface{} {
+	if denom == "BNB" {
+		return models.Transfer{
+			Value: models.Amount(amount),
 		}
 	}
 
-	return tx, false
+	info, _ := p.tokens.Get(denom)
+	return models.TokenTransfer{
+		Name:     info.Name,
+		Symbol:   info.OriginalSymbol,
+		TokenID:  denom,
+		Decimals: 8,
+		From:     from,
+		To:       to,
+		Value:    models.Amount(amount),
+	}
+}
+
+// getMultisendParties returns the inputs/outputs of the multisend at hash,
+// fetching the receipt at most once per hash per call to GetTxsOfAddress.
+func (p *Platform) getMultisendParties(hash string, receipts map[string]multisendParties) (multisendParties, error) {
+	if parties, ok := receipts[hash]; ok {
+		return parties, nil
+	}
+
+	receipt, err := p.client.getTransactionReceipt(hash)
+	if err != nil {
+		return multisendParties{}, err
+	}
+
+	msgValue := receipt.TxReceipts.Value.Msg[0].MsgValue
+	parties := multisendParties{
+		Inputs:  msgValue.Inputs,
+		Outputs: msgValue.Outputs,
+	}
+	receipts[hash] = parties
+	return parties, nil
 }
 
-func getCoinOutput(outputs []Output, address string) Coin {
-	var coin Coin
-	for _, out := range outputs {
-		if out.Address == address {
-			coin = out.Coins[0]
+// inputTotalsByDenom sums every input's coins by denom, the denominator used
+// to prorate how much of a shared output belongs to any one sender.
+func inputTotalsByDenom(inputs []Input) map[string]*big.Rat {
+	totals := make(map[string]*big.Rat)
+	for _, in := range inputs {
+		for _, c := range in.Coins {
+			amount, ok := new(big.Rat).SetString(string(c.Amount))
+			if !ok {
+				continue
+			}
+			if totals[c.Denom] == nil {
+				totals[c.Denom] = new(big.Rat)
+			}
+			totals[c.Denom].Add(totals[c.Denom], amount)
+		}
+	}
+	return totals
+}
+
+// senderShare returns address's fraction of the total input for denom (zero
+// if address contributed none of it), so a multisend output funded by
+// several senders can be split instead of credited in full to each of them.
+func senderShare(inputs []Input, address, denom string, totals map[string]*big.Rat) *big.Rat {
+	total := totals[denom]
+	if total == nil || total.Sign() == 0 {
+		return new(big.Rat)
+	}
+
+	contributed := new(big.Rat)
+	for _, in := range inputs {
+		if in.Address != address {
 			continue
 		}
+		for _, c := range in.Coins {
+			if c.Denom != denom {
+				continue
+			}
+			amount, ok := new(big.Rat).SetString(string(c.Amount))
+			if !ok {
+				continue
+			}
+			contributed.Add(contributed, amount)
+		}
 	}
 
-	return coin
+	return new(big.Rat).Quo(contributed, total)
+}
+
+// mustParseRat parses a Coin amount string. Malformed amounts are a data bug
+// upstream, not something a request can trigger, so a zero value is the
+// safest fallback rather than propagating a parse error through Normalize's
+// (txs, ok) signature.
+func mustParseRat(amount string) *big.Rat {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// formatRat renders r as a plain decimal string trimmed of trailing zeros,
+// matching the raw amount strings Binance Chain returns rather than padding
+// every prorated amount out to 8 fixed decimals.
+func formatRat(r *big.Rat) string {
+	s := r.FloatString(8)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "" {
+		return "0"
+	}
+	return s
 }
 
 func apiError(c *gin.Context, err error) bool {
@@ -0,0 +1,147 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/trustwallet/blockatlas/models"
+)
+
+func TestNormalizeMultisendSplitsByOutputCoins(t *testing.T) {
+	p := &Platform{
+		tokens: &TokenInfoCache{started: true, tokens: map[string]TokenInfo{}},
+	}
+
+	srcTx := &Tx{
+		Hash: "tx1",
+		Type: TRANSFER,
+	}
+
+	receipts := map[string]multisendParties{
+		"tx1": {
+			Inputs: []Input{
+				{Address: "sender", Coins: []Coin{{Denom: "BNB", Amount: "300"}}},
+			},
+			Outputs: []Output{
+				{Address: "alice", Coins: []Coin{{Denom: "BNB", Amount: "100"}}},
+				{Address: "bob", Coins: []Coin{{Denom: "BNB", Amount: "200"}}},
+			},
+		},
+	}
+
+	legs, ok := p.Normalize(srcTx, "", "sender", receipts)
+	if !ok {
+		t.Fatal("expected Normalize to report legs")
+	}
+	if len(legs) != 2 {
+		t.Fatalf("expected 2 legs (one per output), got %d", len(legs))
+	}
+
+	got := make(map[string]models.Amount, len(legs))
+	for _, leg := range legs {
+		transfer, ok := leg.Meta.(models.Transfer)
+		if !ok {
+			t.Fatalf("expected models.Transfer meta, got %T", leg.Meta)
+		}
+		got[leg.To] = transfer.Value
+	}
+
+	want := map[string]models.Amount{"alice": "100", "bob": "200"}
+	for to, value := range want {
+		if got[to] != value {
+			t.Errorf("leg to %s: got value %q, want %q", to, got[to], value)
+		}
+	}
+}
+
+func TestNormalizeMultisendSkipsSelfChangeOutputInSenderLeg(t *testing.T) {
+	p := &Platform{
+		tokens: &TokenInfoCache{started: true, tokens: map[string]TokenInfo{}},
+	}
+
+	srcTx := &Tx{
+		Hash: "tx1",
+		Type: TRANSFER,
+	}
+
+	receipts := map[string]multisendParties{
+		"tx1": {
+			Inputs: []Input{
+				{Address: "address", Coins: []Coin{{Denom: "BNB", Amount: "300"}}},
+			},
+			Outputs: []Output{
+				{Address: "address", Coins: []Coin{{Denom: "BNB", Amount: "50"}}},
+				{Address: "bob", Coins: []Coin{{Denom: "BNB", Amount: "250"}}},
+			},
+		},
+	}
+
+	legs, ok := p.Normalize(srcTx, "", "address", receipts)
+	if !ok {
+		t.Fatal("expected Normalize to report legs")
+	}
+	if len(legs) != 2 {
+		t.Fatalf("expected 2 legs (one outgoing to bob, one self change), got %d", len(legs))
+	}
+
+	var toBob, selfChange int
+	for _, leg := range legs {
+		transfer := leg.Meta.(models.Transfer)
+		switch {
+		case leg.From == "address" && leg.To == "bob":
+			toBob++
+			if transfer.Value != "250" {
+				t.Errorf("address->bob leg: got value %q, want %q", transfer.Value, "250")
+			}
+		case leg.From == "address" && leg.To == "address":
+			selfChange++
+			if transfer.Value != "50" {
+				t.Errorf("self-change leg: got value %q, want %q", transfer.Value, "50")
+			}
+		default:
+			t.Errorf("unexpected leg: %+v", leg)
+		}
+	}
+	if toBob != 1 {
+		t.Errorf("expected exactly 1 address->bob leg, got %d", toBob)
+	}
+	if selfChange != 1 {
+		t.Errorf("expected exactly 1 self-change leg, got %d", selfChange)
+	}
+}
+
+func TestNormalizeMultisendProratesSenderShareAcrossMultipleInputs(t *testing.T) {
+	p := &Platform{
+		tokens: &TokenInfoCache{started: true, tokens: map[string]TokenInfo{}},
+	}
+
+	srcTx := &Tx{
+		Hash: "tx1",
+		Type: TRANSFER,
+	}
+
+	receipts := map[string]multisendParties{
+		"tx1": {
+			Inputs: []Input{
+				{Address: "alice", Coins: []Coin{{Denom: "BNB", Amount: "100"}}},
+				{Address: "bob", Coins: []Coin{{Denom: "BNB", Amount: "200"}}},
+			},
+			Outputs: []Output{
+				{Address: "charlie", Coins: []Coin{{Denom: "BNB", Amount: "300"}}},
+			},
+		},
+	}
+
+	legs, ok := p.Normalize(srcTx, "", "alice", receipts)
+	if !ok {
+		t.Fatal("expected Normalize to report legs")
+	}
+	if len(legs) != 1 {
+		t.Fatalf("expected exactly 1 leg (alice's own contribution), got %d", len(legs))
+	}
+
+	transfer := legs[0].Meta.(models.Transfer)
+	if legs[0].From != "alice" || legs[0].To != "charlie" || transfer.Value != "100" {
+		t.Errorf("got {from:%s to:%s value:%s}, want {from:alice to:charlie value:100}",
+			legs[0].From, legs[0].To, transfer.Value)
+	}
+}
@@ -0,0 +1,174 @@
+package binance
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/trustwallet/blockatlas/models"
+)
+
+const (
+	streamPollInterval = 5 * time.Second
+	streamHeartbeat    = 15 * time.Second
+)
+
+// sub is a single subscribed address. One background poller is shared by
+// every gin context streaming that address, deduped through subs below.
+type sub struct {
+	address string
+
+	mu          sync.Mutex
+	subscribers map[chan models.Tx]struct{}
+	lastSeen    int64 // latest models.Tx.Date already delivered
+}
+
+func newSub(address string) *sub {
+	return &sub{
+		address:     address,
+		subscribers: make(map[chan models.Tx]struct{}),
+		lastSeen:    time.Now().Unix(),
+	}
+}
+
+func (s *sub) add(ch chan models.Tx) {
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+}
+
+// remove drops ch and reports how many subscribers are left.
+func (s *sub) remove(ch chan models.Tx) int {
+	s.mu.Lock()
+	delete(s.subscribers, ch)
+	remaining := len(s.subscribers)
+	s.mu.Unlock()
+	return remaining
+}
+
+func (s *sub) broadcast(tx models.Tx) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- tx:
+		default: // a slow client shouldn't stall the poller
+		}
+	}
+}
+
+// subs holds one *sub per address currently being polled, so N clients
+// watching the same address share a single poller instead of each starting
+// their own. subsMu guards both the map and each sub's subscriber count as
+// one invariant: a subscribe can never attach to a sub that an in-flight
+// unsubscribe is about to (or just did) evict.
+var (
+	subsMu sync.Mutex
+	subs   = make(map[string]*sub)
+)
+
+// subscribe registers ch with the poller for address, starting the poller
+// if this is the first subscriber.
+func (p *Platform) subscribe(address string) (*sub, chan models.Tx) {
+	subsMu.Lock()
+	defer subsMu.Unlock()
+
+	s, ok := subs[address]
+	if !ok {
+		s = newSub(address)
+		subs[address] = s
+		go p.pollAddress(s)
+	}
+
+	ch := make(chan models.Tx, 16)
+	s.add(ch)
+	return s, ch
+}
+
+// unsubscribe removes ch, tearing down the poller once the last subscriber
+// for this address has disconnected. Held under the same lock as subscribe
+// so a concurrent resubscribe either lands on this sub before it's evicted,
+// or finds it gone and starts a fresh poller — never attaches to a sub
+// whose poller has already exited.
+func (p *Platform) unsubscribe(s *sub, ch chan models.Tx) {
+	subsMu.Lock()
+	defer subsMu.Unlock()
+
+	if s.remove(ch) == 0 && subs[s.address] == s {
+		delete(subs, s.address)
+	}
+	close(ch)
+}
+
+// pollAddress periodically fetches address's transactions and fans out any
+// that weren't already delivered, until the last subscriber goes away.
+func (p *Platform) pollAddress(s *sub) {
+	ticker := time.NewTicker(streamPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Stop once this poller's sub has been replaced or removed — not
+		// just when the address has no entry — so a disconnect/resubscribe
+		// within one tick can't leave this goroutine believing it's current.
+		subsMu.Lock()
+		current := subs[s.address]
+		subsMu.Unlock()
+		if current != s {
+			return
+		}
+
+		txs, err := p.GetTxsOfAddress(s.address, "")
+		if err != nil {
+			continue
+		}
+
+		cursor := s.lastSeen
+		for _, tx := range txs {
+			if tx.Date <= s.lastSeen {
+				continue
+			}
+			s.broadcast(tx)
+			if tx.Date > cursor {
+				cursor = tx.Date
+			}
+		}
+		s.lastSeen = cursor
+	}
+}
+
+// streamTxs streams newly confirmed transactions for address as Server-Sent
+// Events, so clients don't have to poll GET /:address on a timer.
+func (p *Platform) streamTxs(c *gin.Context) {
+	address := c.Param("address")
+
+	s, ch := p.subscribe(address)
+	defer p.unsubscribe(s, ch)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case tx, ok := <-ch:
+			if !ok {
+				return false
+			}
+			c.SSEvent("tx", tx)
+			return true
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/trustwallet/blockatlas/models"
+)
+
+const (
+	tokenInfoRefreshInterval = 10 * time.Minute
+	tokensPageSize           = 1000
+)
+
+// TokenInfo describes a BEP2 token as registered on the Binance DEX.
+type TokenInfo struct {
+	Name           string `json:"name"`
+	Symbol         string `json:"symbol"`
+	OriginalSymbol string `json:"original_symbol"`
+	TotalSupply    string `json:"total_supply"`
+	Owner          string `json:"owner"`
+}
+
+// TokenInfoCache lazily loads BEP2 token metadata from the DEX
+// /api/v1/tokens endpoint and refreshes it in the background, so Normalize
+// never blocks a request on a token list fetch after the first successful
+// one. Until a fetch succeeds, every lookup retries the fetch synchronously.
+type TokenInfoCache struct {
+	client Client
+
+	mu      sync.RWMutex
+	tokens  map[string]TokenInfo
+	group   singleflight.Group
+	started bool
+}
+
+// Get returns the cached metadata for symbol (the full DEX denom, e.g.
+// "ANN-457"), loading the cache on first use.
+func (c *TokenInfoCache) Get(symbol string) (TokenInfo, bool) {
+	c.ensureLoaded()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	info, ok := c.tokens[symbol]
+	return info, ok
+}
+
+// List returns every cached token, loading the cache on first use.
+func (c *TokenInfoCache) List() []TokenInfo {
+	c.ensureLoaded()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	tokens := make([]TokenInfo, 0, len(c.tokens))
+	for _, info := range c.tokens {
+		tokens = append(tokens, info)
+	}
+	return tokens
+}
+
+// ensureLoaded blocks for the very first load and starts the background
+// refresh loop once. Concurrent callers collapse onto a single fetch via
+// the singleflight group inside refresh.
+func (c *TokenInfoCache) ensureLoaded() {
+	c.mu.RLock()
+	loaded := c.tokens != nil
+	started := c.started
+	c.mu.RUnlock()
+
+	if !loaded {
+		c.refresh()
+	}
+
+	if !started {
+		c.mu.Lock()
+		if !c.started {
+			c.started = true
+			go c.refreshLoop()
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *TokenInfoCache) refreshLoop() {
+	ticker := time.NewTicker(tokenInfoRefreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		c.refresh()
+	}
+}
+
+func (c *TokenInfoCache) refresh() {
+	_, _, _ = c.group.Do("refresh", func() (interface{}, error) {
+		tokens, err := c.fetchAll()
+		if err != nil {
+			logrus.WithError(err).Error("failed to refresh Binance DEX token list")
+			return nil, err
+		}
+
+		bySymbol := make(map[string]TokenInfo, len(tokens))
+		for _, t := range tokens {
+			bySymbol[t.Symbol] = t
+		}
+
+		c.mu.Lock()
+		c.tokens = bySymbol
+		c.mu.Unlock()
+		return nil, nil
+	})
+}
+
+func (c *TokenInfoCache) fetchAll() ([]TokenInfo, error) {
+	var all []TokenInfo
+	for offset := 0; ; offset += tokensPageSize {
+		url := fmt.Sprintf("%s/api/v1/tokens?offset=%d&limit=%d", c.client.ExplorerBaseURL, offset, tokensPageSize)
+		resp, err := c.client.HTTPClient.Get(url)
+		if err != nil {
+			return nil, models.ErrSourceConn
+		}
+
+		var page []TokenInfo
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+		if len(page) < tokensPageSize {
+			break
+		}
+	}
+	return all, nil
+}
@@ -0,0 +1,100 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/trustwallet/blockatlas/models"
+)
+
+// UnsignedTx is the payload handed to a Wallet backend for signing. It
+// covers both native BNB transfers and BEP2 token transfers.
+type UnsignedTx struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+	Memo   string `json:"memo,omitempty"`
+}
+
+// Wallet signs transactions on blockatlas' behalf without blockatlas ever
+// holding a private key, mirroring Lotus' remote wallet backend model.
+type Wallet interface {
+	// SignTx returns the signed, broadcast-ready transaction bytes for unsignedTx.
+	SignTx(ctx context.Context, unsignedTx UnsignedTx) ([]byte, error)
+}
+
+// RemoteWallet is the default Wallet: it forwards signing requests over
+// JSON-RPC-style HTTP to an external signer configured via binance.signer_url.
+type RemoteWallet struct {
+	HTTPClient *http.Client
+	SignerURL  string
+}
+
+type signTxRequest struct {
+	Tx UnsignedTx `json:"tx"`
+}
+
+type signTxResponse struct {
+	SignedTx []byte `json:"signed_tx"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SignTx implements Wallet
+func (w *RemoteWallet) SignTx(ctx context.Context, unsignedTx UnsignedTx) ([]byte, error) {
+	body, err := json.Marshal(signTxRequest{Tx: unsignedTx})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.SignerURL+"/sign", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.HTTPClient.Do(req)
+	if err != nil {
+		return nil, models.ErrSourceConn
+	}
+	defer resp.Body.Close()
+
+	var signed signTxResponse
+	if err := json.NewDecoder(resp.Body).Decode(&signed); err != nil {
+		return nil, err
+	}
+	if signed.Error != "" {
+		return nil, fmt.Errorf("signer: %s", signed.Error)
+	}
+
+	return signed.SignedTx, nil
+}
+
+// BroadcastResult is the Binance Chain node's response to a broadcast transaction.
+type BroadcastResult struct {
+	Hash string `json:"hash"`
+	Code int    `json:"code"`
+	Log  string `json:"log"`
+}
+
+// Broadcast submits signed transaction bytes to the chain via c.RPCBaseURL.
+func (c *Client) Broadcast(signedTx []byte) (*BroadcastResult, error) {
+	url := fmt.Sprintf("%s/broadcast_tx_commit?tx=0x%x", c.RPCBaseURL, signedTx)
+	resp, err := c.HTTPClient.Post(url, "application/octet-stream", nil)
+	if err != nil {
+		return nil, models.ErrSourceConn
+	}
+	defer resp.Body.Close()
+
+	var results []BroadcastResult
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return &results[0], nil
+}
@@ -0,0 +1,49 @@
+// Package platform defines the contract every supported blockchain must
+// implement so that main can wire it up without knowing anything about the
+// chain itself.
+package platform
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/models"
+	"github.com/trustwallet/blockatlas/util"
+)
+
+// Platform is implemented by every chain-specific package (binance, and
+// eventually cosmos, ethereum, bitcoin, ...). It mirrors blockbook's
+// BlockChain interface: a chain only has to describe itself, and the router
+// takes care of config validation and route registration.
+type Platform interface {
+	// Coin identifies which chain this platform serves.
+	Coin() coin.Coin
+
+	// Init configures the platform from application config, e.g. API
+	// endpoints and credentials. It is called once before RegisterRoutes.
+	Init(v *viper.Viper) error
+
+	// RequiredConfigKeys lists the viper keys that must be set for this
+	// platform to function. Setup aborts requests with a clear error if any
+	// of them are missing instead of letting the platform fail at runtime.
+	RequiredConfigKeys() []string
+
+	// RegisterRoutes attaches the platform's gin routes to router.
+	RegisterRoutes(router gin.IRouter)
+
+	// GetTxsOfAddress returns the normalized transactions touching address,
+	// optionally filtered to a single token.
+	GetTxsOfAddress(address, token string) ([]models.Tx, error)
+}
+
+// Setup wires a Platform into router: it installs the required-config
+// middleware for every key the platform declares, then lets the platform
+// register its own routes. Callers add one Setup call per platform instead
+// of hand-rolling middleware per chain.
+func Setup(router gin.IRouter, p Platform) {
+	for _, key := range p.RequiredConfigKeys() {
+		router.Use(util.RequireConfig(key))
+	}
+	p.RegisterRoutes(router)
+}